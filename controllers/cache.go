@@ -8,8 +8,14 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
 	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
@@ -25,8 +31,33 @@ type CacheOptions struct {
 	Resync *time.Duration
 }
 
+// NamespacedCacheOptions customises how an individual object passed to
+// Watch is cached.
+type NamespacedCacheOptions struct {
+	// OnlyMetadata requests a metadata-only informer for this watch. The API
+	// server is queried with the PartialObjectMetadata accept header, so
+	// only ObjectMeta is ever deserialised and kept in the cache. obj passed
+	// to Watch must be a *metav1.PartialObjectMetadata with APIVersion and
+	// Kind set when this is true.
+	OnlyMetadata bool
+}
+
+// WatchRegistration is returned by Watch and lets a caller tear the watch it
+// registered back down.
+type WatchRegistration interface {
+	// Remove stops delivering events for the watched object and prunes it
+	// from the cache. If it was the last registration for the object's
+	// namespace, that namespace's underlying cache/informers are stopped
+	// too.
+	Remove() error
+}
+
 type NamespacedCache interface {
-	Watch(ctx context.Context, obj client.Object) error
+	Watch(ctx context.Context, obj client.Object, opts ...NamespacedCacheOptions) (WatchRegistration, error)
+	// RemoveAllForNamespace removes every watch registration for namespace
+	// in one call, e.g. so a resource syncer can drop everything for a
+	// tenant being torn down.
+	RemoveAllForNamespace(namespace string) error
 	EventStream() <-chan event.GenericEvent
 }
 
@@ -54,93 +85,231 @@ func NewNamespacedCache(opts CacheOptions) (NamespacedCache, error) {
 		opts.Resync = &defaultResyncTime
 	}
 
+	metadataClient, err := metadata.NewForConfig(opts.Config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create metadata client from config: %v", err)
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(opts.Config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create dynamic client from config: %v", err)
+	}
+
 	return &namespacedCache{
-		caches:           make(map[string]cache.Cache),
-		config:           opts.Config,
-		mapper:           opts.Mapper,
-		scheme:           opts.Scheme,
-		resync:           opts.Resync,
-		eventChan:        make(chan event.GenericEvent),
-		watchedResources: make(map[string]map[string]struct{}),
+		caches:                make(map[string]cache.Cache),
+		metadataInformers:     make(map[string]map[schema.GroupVersionKind]cache.Informer),
+		unstructuredInformers: make(map[string]map[schema.GroupVersionKind]cache.Informer),
+		config:                opts.Config,
+		metadataClient:        metadataClient,
+		dynamicClient:         dynamicClient,
+		mapper:                opts.Mapper,
+		scheme:                opts.Scheme,
+		resync:                opts.Resync,
+		eventChan:             make(chan event.GenericEvent),
+		watchedResources:      make(map[string]map[string]*watchRegistration),
+		namespaceCtx:          make(map[string]context.Context),
+		namespaceCancel:       make(map[string]context.CancelFunc),
 	}, nil
 }
 
 type namespacedCache struct {
-	caches           map[string]cache.Cache
-	config           *rest.Config
-	mapper           meta.RESTMapper
-	scheme           *runtime.Scheme
-	resync           *time.Duration
-	eventChan        chan event.GenericEvent
-	watchedResources map[string]map[string]struct{}
+	// caches holds the per-namespace typed object caches. Unstructured
+	// objects are never stored here - see unstructuredInformers - so that a
+	// namespace can be watched through both without either requiring the
+	// other's type to be registered in the scheme.
+	caches map[string]cache.Cache
+	// metadataInformers holds the metadata-only informers, which are kept
+	// separate from the full-object caches above since they are backed by
+	// the metadata client rather than a scheme-aware dynamic client.
+	metadataInformers map[string]map[schema.GroupVersionKind]cache.Informer
+	// unstructuredInformers holds informers for unstructured objects, kept
+	// separate from caches since they are backed by the dynamic client and
+	// so never need their GVK registered in the scheme.
+	unstructuredInformers map[string]map[schema.GroupVersionKind]cache.Informer
+	config                *rest.Config
+	metadataClient        metadata.Interface
+	dynamicClient         dynamic.Interface
+	mapper                meta.RESTMapper
+	scheme                *runtime.Scheme
+	resync                *time.Duration
+	eventChan             chan event.GenericEvent
+	watchedResources      map[string]map[string]*watchRegistration
+	// namespaceCtx/namespaceCancel hold one long-lived context per namespace
+	// that has an active watch, independent of the context any individual
+	// Watch call was made with. The typed cache and metadata/unstructured
+	// informers for a namespace run off this context, which is cancelled to
+	// garbage collect them once the last watchRegistration for the
+	// namespace is removed.
+	namespaceCtx    map[string]context.Context
+	namespaceCancel map[string]context.CancelFunc
+}
+
+// watchRegistration is the WatchRegistration handed back to callers of
+// Watch. It keeps what's needed to remove its own event handler and prune
+// itself from its namespacedCache's bookkeeping.
+type watchRegistration struct {
+	cache      *namespacedCache
+	namespace  string
+	key        string
+	informer   cache.Informer
+	handlerReg toolscache.ResourceEventHandlerRegistration
+}
+
+func (r *watchRegistration) Remove() error {
+	return r.cache.remove(r)
 }
 
 func (n *namespacedCache) EventStream() <-chan event.GenericEvent {
 	return n.eventChan
 }
 
-func (n *namespacedCache) Watch(ctx context.Context, obj client.Object) error {
-	// Check that namespace scoped objects have their namespace set
-	if err := n.ensureNamespace(obj); err != nil {
-		return err
+func (n *namespacedCache) Watch(ctx context.Context, obj client.Object, opts ...NamespacedCacheOptions) (WatchRegistration, error) {
+	var opt NamespacedCacheOptions
+	if len(opts) > 0 {
+		opt = opts[0]
 	}
 
-	namespacedWatches, ok := n.watchedResources[obj.GetNamespace()]
-	if !ok {
-		// No watch set up for this namespace yet
-		return n.watch(ctx, obj)
+	// Check that namespace scoped objects have their namespace set
+	if err := n.ensureNamespace(obj); err != nil {
+		return nil, err
 	}
 
-	key, err := n.watchKey(obj)
+	key, err := n.watchKey(obj, opt)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if _, ok := namespacedWatches[key]; !ok {
-		// watch not set up for this object yet
-		return n.watch(ctx, obj)
+	if namespacedWatches, ok := n.watchedResources[obj.GetNamespace()]; ok {
+		if reg, ok := namespacedWatches[key]; ok {
+			// watch already set up for this object
+			return reg, nil
+		}
 	}
 
-	return nil
+	return n.watch(ctx, obj, opt, key)
 }
 
-func (n *namespacedCache) watch(ctx context.Context, obj client.Object) error {
-	informer, err := n.getInformer(ctx, obj)
-	if err != nil {
-		return nil
-	}
+func (n *namespacedCache) watch(ctx context.Context, obj client.Object, opt NamespacedCacheOptions, key string) (WatchRegistration, error) {
+	namespace := obj.GetNamespace()
+	nsCtx := n.namespaceContext(namespace)
 
-	// Get the key before we set up the event to ensure we can mark the key in the watchedResources map
-	key, err := n.watchKey(obj)
+	informer, err := n.getInformer(nsCtx, obj, opt)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Add an event handler that only allows events through for the correct object name
 	// Since the informer is namespace bound, this should limit the events from this event handler to a single resource.
-	informer.AddEventHandler(&eventToChannelHandler{
+	handlerReg, err := informer.AddEventHandler(&eventToChannelHandler{
 		name:       obj.GetName(),
 		eventsChan: n.eventChan,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	reg := &watchRegistration{
+		cache:      n,
+		namespace:  namespace,
+		key:        key,
+		informer:   informer,
+		handlerReg: handlerReg,
+	}
+
+	namespacedWatches, ok := n.watchedResources[namespace]
+	if !ok {
+		namespacedWatches = make(map[string]*watchRegistration)
+		n.watchedResources[namespace] = namespacedWatches
+	}
+	namespacedWatches[key] = reg
+
+	return reg, nil
+}
+
+// remove tears down reg's event handler and, if it was the last
+// registration for its namespace, stops that namespace's cache/informers.
+func (n *namespacedCache) remove(reg *watchRegistration) error {
+	if err := reg.informer.RemoveEventHandler(reg.handlerReg); err != nil {
+		return err
+	}
+
+	namespacedWatches, ok := n.watchedResources[reg.namespace]
+	if !ok {
+		return nil
+	}
+
+	delete(namespacedWatches, reg.key)
+	if len(namespacedWatches) == 0 {
+		delete(n.watchedResources, reg.namespace)
+		n.stopNamespace(reg.namespace)
+	}
+
+	return nil
+}
 
-	namespacedWatches, ok := n.watchedResources[obj.GetNamespace()]
+func (n *namespacedCache) RemoveAllForNamespace(namespace string) error {
+	namespacedWatches, ok := n.watchedResources[namespace]
 	if !ok {
-		namespacedWatches = make(map[string]struct{})
-		n.watchedResources[obj.GetNamespace()] = namespacedWatches
+		return nil
+	}
+
+	for _, reg := range namespacedWatches {
+		if err := reg.informer.RemoveEventHandler(reg.handlerReg); err != nil {
+			return err
+		}
 	}
-	namespacedWatches[key] = struct{}{}
+
+	delete(n.watchedResources, namespace)
+	n.stopNamespace(namespace)
 
 	return nil
 }
 
+// namespaceContext returns the long-lived context backing namespace's cache
+// and metadata informers, creating it if this is the first watch for the
+// namespace.
+func (n *namespacedCache) namespaceContext(namespace string) context.Context {
+	if ctx, ok := n.namespaceCtx[namespace]; ok {
+		return ctx
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	n.namespaceCtx[namespace] = ctx
+	n.namespaceCancel[namespace] = cancel
+
+	return ctx
+}
+
+// stopNamespace cancels namespace's shared context, stopping its typed
+// cache and any metadata-only informers, and prunes the now-empty
+// per-namespace state.
+func (n *namespacedCache) stopNamespace(namespace string) {
+	if cancel, ok := n.namespaceCancel[namespace]; ok {
+		cancel()
+	}
+	delete(n.namespaceCancel, namespace)
+	delete(n.namespaceCtx, namespace)
+	delete(n.caches, namespace)
+	delete(n.metadataInformers, namespace)
+	delete(n.unstructuredInformers, namespace)
+}
+
 // getInformer gets a namespace limited informer for the object kind given.
 // All non-namespaced objects will share a cluster wide cache.
 // This cache should never be used for namespace scoped objects.
-func (n *namespacedCache) getInformer(ctx context.Context, obj client.Object) (cache.Informer, error) {
+func (n *namespacedCache) getInformer(ctx context.Context, obj client.Object, opt NamespacedCacheOptions) (cache.Informer, error) {
 	if err := n.ensureNamespace(obj); err != nil {
 		return nil, err
 	}
 
+	if opt.OnlyMetadata {
+		return n.getMetadataInformer(ctx, obj)
+	}
+
+	if isUnstructuredObject(obj) {
+		return n.getUnstructuredInformer(ctx, obj)
+	}
+
 	c, ok := n.caches[obj.GetNamespace()]
 	if ok {
 		return c.GetInformer(ctx, obj)
@@ -161,6 +330,85 @@ func (n *namespacedCache) getInformer(ctx context.Context, obj client.Object) (c
 	return c.GetInformer(ctx, obj)
 }
 
+// getMetadataInformer returns a metadata-only informer for obj's GVK, scoped
+// to obj's namespace, constructing one if this is the first watch for that
+// GVK. The informer is backed by the metadata client, so the API server is
+// queried with the PartialObjectMetadata accept header and only ObjectMeta
+// is ever deserialised and cached.
+func (n *namespacedCache) getMetadataInformer(ctx context.Context, obj client.Object) (cache.Informer, error) {
+	return n.getOrCreateInformer(obj, n.metadataInformers, func(mapping *meta.RESTMapping, namespace string) cache.Informer {
+		informer := metadatainformer.NewFilteredMetadataInformer(
+			n.metadataClient, mapping.Resource, namespace, *n.resync, toolscache.Indexers{}, nil,
+		).Informer()
+		go informer.Run(ctx.Done())
+		return informer
+	})
+}
+
+// getUnstructuredInformer returns an informer for obj's GVK, scoped to obj's
+// namespace, constructing one if this is the first watch for that GVK. The
+// informer is backed by the dynamic client, so obj's type never needs to be
+// registered in the scheme.
+func (n *namespacedCache) getUnstructuredInformer(ctx context.Context, obj client.Object) (cache.Informer, error) {
+	return n.getOrCreateInformer(obj, n.unstructuredInformers, func(mapping *meta.RESTMapping, namespace string) cache.Informer {
+		informer := dynamicinformer.NewFilteredDynamicInformer(
+			n.dynamicClient, mapping.Resource, namespace, *n.resync, toolscache.Indexers{}, nil,
+		).Informer()
+		go informer.Run(ctx.Done())
+		return informer
+	})
+}
+
+// getOrCreateInformer looks up obj's GVK within informers[obj.GetNamespace()],
+// building it with build and caching the result if this is the first watch
+// for that GVK. It holds the lookup/RESTMapping plumbing shared by
+// getMetadataInformer and getUnstructuredInformer, which differ only in how
+// the informer itself is constructed.
+func (n *namespacedCache) getOrCreateInformer(
+	obj client.Object,
+	informers map[string]map[schema.GroupVersionKind]cache.Informer,
+	build func(mapping *meta.RESTMapping, namespace string) cache.Informer,
+) (cache.Informer, error) {
+	gvk, err := apiutil.GVKForObject(obj, n.scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := obj.GetNamespace()
+	nsInformers, ok := informers[namespace]
+	if !ok {
+		nsInformers = make(map[schema.GroupVersionKind]cache.Informer)
+		informers[namespace] = nsInformers
+	}
+
+	if informer, ok := nsInformers[gvk]; ok {
+		return informer, nil
+	}
+
+	mapping, err := n.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, fmt.Errorf("could not get REST mapping for %s: %v", gvk, err)
+	}
+
+	informer := build(mapping, namespace)
+	nsInformers[gvk] = informer
+
+	return informer, nil
+}
+
+// isUnstructuredObject reports whether obj is an unstructured object such as
+// *unstructured.Unstructured, which must be routed to the dynamic
+// client-backed informer rather than the scheme-aware typed cache.
+func isUnstructuredObject(obj client.Object) bool {
+	_, ok := obj.(runtime.Unstructured)
+	return ok
+}
+
+// isNamespaced reports whether obj's GVK is namespace scoped. obj's GVK is
+// read via apiutil.GVKForObject, which takes it from obj's own TypeMeta for
+// unstructured and PartialObjectMetadata objects, so their types need not be
+// registered in the scheme; any other typed object must be. The scope is
+// then resolved with a RESTMapping lookup by that GVK's GroupKind.
 func (n *namespacedCache) isNamespaced(obj client.Object) (bool, error) {
 	gvk, err := apiutil.GVKForObject(obj, n.scheme)
 	if err != nil {
@@ -184,12 +432,19 @@ func (n *namespacedCache) ensureNamespace(obj client.Object) error {
 	return nil
 }
 
-func (n *namespacedCache) watchKey(obj client.Object) (string, error) {
+func (n *namespacedCache) watchKey(obj client.Object, opt NamespacedCacheOptions) (string, error) {
 	gvk, err := apiutil.GVKForObject(obj, n.scheme)
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf("%s/%s", gvk.GroupKind().String(), obj.GetName()), nil
+	key := fmt.Sprintf("%s/%s", gvk.GroupKind().String(), obj.GetName())
+	if opt.OnlyMetadata {
+		key += "/metadata-only"
+	}
+	if isUnstructuredObject(obj) {
+		key += "/unstructured"
+	}
+	return key, nil
 }
 
 type eventToChannelHandler struct {
@@ -221,6 +476,7 @@ func (e *eventToChannelHandler) queueEventForObject(o interface{}) {
 	}
 	if obj.GetName() != e.name {
 		// Not the right object, skip
+		return
 	}
 
 	// Send an event to the events channel
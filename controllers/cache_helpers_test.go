@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/metadata"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// newTestNamespacedCache builds a namespacedCache with every map
+// initialised, so Watch/remove bookkeeping doesn't panic on a nil map, but
+// without any real cluster config behind it. Tests that need a metadata or
+// dynamic client wire one in through the corresponding parameter; the rest
+// can be left nil. config is always left nil, so the typed cache path
+// cannot be exercised through this helper.
+func newTestNamespacedCache(scheme *runtime.Scheme, mapper meta.RESTMapper, metadataClient metadata.Interface, dynamicClient dynamic.Interface) *namespacedCache {
+	resync := 10 * time.Hour
+
+	return &namespacedCache{
+		caches:                make(map[string]cache.Cache),
+		metadataInformers:     make(map[string]map[schema.GroupVersionKind]cache.Informer),
+		unstructuredInformers: make(map[string]map[schema.GroupVersionKind]cache.Informer),
+		metadataClient:        metadataClient,
+		dynamicClient:         dynamicClient,
+		mapper:                mapper,
+		scheme:                scheme,
+		resync:                &resync,
+		eventChan:             make(chan event.GenericEvent, 10),
+		watchedResources:      make(map[string]map[string]*watchRegistration),
+		namespaceCtx:          make(map[string]context.Context),
+		namespaceCancel:       make(map[string]context.CancelFunc),
+	}
+}
@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	metadatafake "k8s.io/client-go/metadata/fake"
+)
+
+// TestWatchOnlyMetadata verifies that watching an object with
+// NamespacedCacheOptions{OnlyMetadata: true} delivers events carrying the
+// PartialObjectMetadata the informer received, without ever deserialising
+// the full object (e.g. a Pod's spec).
+func TestWatchOnlyMetadata(t *testing.T) {
+	podGVK := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	podListGVK := schema.GroupVersionKind{Version: "v1", Kind: "PodList"}
+
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypeWithName(podGVK, &metav1.PartialObjectMetadata{})
+	scheme.AddKnownTypeWithName(podListGVK, &metav1.PartialObjectMetadataList{})
+
+	seed := &metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pod", Namespace: "my-namespace"},
+	}
+	metadataClient := metadatafake.NewSimpleMetadataClient(scheme, seed)
+
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{podGVK.GroupVersion()})
+	mapper.Add(podGVK, meta.RESTScopeNamespace)
+
+	n := newTestNamespacedCache(scheme, mapper, metadataClient, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watchObj := &metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pod", Namespace: "my-namespace"},
+	}
+
+	if _, err := n.Watch(ctx, watchObj, NamespacedCacheOptions{OnlyMetadata: true}); err != nil {
+		t.Fatalf("Watch returned unexpected error: %v", err)
+	}
+
+	select {
+	case evt := <-n.EventStream():
+		got, ok := evt.Object.(*metav1.PartialObjectMetadata)
+		if !ok {
+			t.Fatalf("expected event object to be a *metav1.PartialObjectMetadata rather than a deserialised Pod, got %T", evt.Object)
+		}
+		if got.GetName() != "my-pod" {
+			t.Fatalf("expected event for pod %q, got %q", "my-pod", got.GetName())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for metadata-only watch event")
+	}
+}
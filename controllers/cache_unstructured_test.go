@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+// TestWatchUnstructuredCRD verifies that watching a CRD instance as
+// *unstructured.Unstructured is served by the dynamic client-backed informer
+// and delivers an event.GenericEvent whose Object round-trips through
+// runtime.DefaultUnstructuredConverter.
+func TestWatchUnstructuredCRD(t *testing.T) {
+	widgetGVK := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+	widgetGVR := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+
+	widget := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata": map[string]interface{}{
+			"name":      "my-widget",
+			"namespace": "my-namespace",
+		},
+		"spec": map[string]interface{}{
+			"size": "large",
+		},
+	}}
+
+	scheme := runtime.NewScheme()
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme, widget)
+
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{widgetGVK.GroupVersion()})
+	mapper.AddSpecific(widgetGVK, widgetGVR, widgetGVR, meta.RESTScopeNamespace)
+
+	n := newTestNamespacedCache(scheme, mapper, nil, dynamicClient)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if _, err := n.Watch(ctx, widget.DeepCopy()); err != nil {
+		t.Fatalf("Watch returned unexpected error: %v", err)
+	}
+
+	select {
+	case evt := <-n.EventStream():
+		got, ok := evt.Object.(*unstructured.Unstructured)
+		if !ok {
+			t.Fatalf("expected event object to be a *unstructured.Unstructured, got %T", evt.Object)
+		}
+
+		var roundTripped struct {
+			Spec struct {
+				Size string `json:"size"`
+			} `json:"spec"`
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(got.Object, &roundTripped); err != nil {
+			t.Fatalf("could not round-trip event object through DefaultUnstructuredConverter: %v", err)
+		}
+		if roundTripped.Spec.Size != "large" {
+			t.Fatalf("expected spec.size %q to round-trip, got %q", "large", roundTripped.Spec.Size)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for unstructured watch event")
+	}
+}
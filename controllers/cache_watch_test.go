@@ -0,0 +1,164 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+	fcache "k8s.io/client-go/tools/cache/testing"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// TestQueueEventForObjectFiltersByName is a regression test for a bug where
+// queueEventForObject fell through and sent an event even when the incoming
+// object's name didn't match the handler's, because the mismatch branch was
+// missing its return.
+func TestQueueEventForObjectFiltersByName(t *testing.T) {
+	eventsChan := make(chan event.GenericEvent, 1)
+	handler := &eventToChannelHandler{name: "wanted", eventsChan: eventsChan}
+
+	handler.OnAdd(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "not-wanted"}})
+
+	select {
+	case evt := <-eventsChan:
+		t.Fatalf("expected no event for a non-matching object name, got %#v", evt)
+	default:
+	}
+}
+
+// TestWatchRegistrationRemoveStopsEvents verifies that once a
+// WatchRegistration is removed, its informer stops delivering events for the
+// object it watched, and the namespace is pruned from watchedResources.
+func TestWatchRegistrationRemoveStopsEvents(t *testing.T) {
+	source := fcache.NewFakeControllerSource()
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "my-pod", Namespace: "my-namespace"}}
+	source.Add(pod)
+
+	informer := toolscache.NewSharedIndexInformer(source, &corev1.Pod{}, 0, toolscache.Indexers{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go informer.Run(ctx.Done())
+
+	if !toolscache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		t.Fatal("informer never synced")
+	}
+
+	n := newTestNamespacedCache(nil, nil, nil, nil)
+
+	handlerReg, err := informer.AddEventHandler(&eventToChannelHandler{
+		name:       pod.Name,
+		eventsChan: n.eventChan,
+	})
+	if err != nil {
+		t.Fatalf("AddEventHandler returned unexpected error: %v", err)
+	}
+
+	reg := &watchRegistration{
+		cache:      n,
+		namespace:  pod.Namespace,
+		key:        "Pod/my-pod",
+		informer:   informer,
+		handlerReg: handlerReg,
+	}
+	n.watchedResources[pod.Namespace] = map[string]*watchRegistration{reg.key: reg}
+
+	select {
+	case <-n.EventStream():
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the initial add event")
+	}
+
+	if err := reg.Remove(); err != nil {
+		t.Fatalf("Remove returned unexpected error: %v", err)
+	}
+
+	if _, ok := n.watchedResources[pod.Namespace]; ok {
+		t.Fatal("expected the namespace to be pruned from watchedResources once its last registration was removed")
+	}
+
+	updated := pod.DeepCopy()
+	updated.Labels = map[string]string{"updated": "true"}
+	if err := source.Modify(updated); err != nil {
+		t.Fatalf("Modify returned unexpected error: %v", err)
+	}
+
+	select {
+	case evt := <-n.EventStream():
+		t.Fatalf("expected no event after Remove, got %#v", evt)
+	case <-time.After(time.Second):
+	}
+}
+
+// TestRemoveAllForNamespace verifies that RemoveAllForNamespace tears down
+// every registration for a namespace in one call.
+func TestRemoveAllForNamespace(t *testing.T) {
+	source := fcache.NewFakeControllerSource()
+	podA := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-a", Namespace: "my-namespace"}}
+	podB := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-b", Namespace: "my-namespace"}}
+	source.Add(podA)
+	source.Add(podB)
+
+	informer := toolscache.NewSharedIndexInformer(source, &corev1.Pod{}, 0, toolscache.Indexers{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go informer.Run(ctx.Done())
+
+	if !toolscache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		t.Fatal("informer never synced")
+	}
+
+	n := newTestNamespacedCache(nil, nil, nil, nil)
+
+	namespacedWatches := make(map[string]*watchRegistration)
+	for _, pod := range []*corev1.Pod{podA, podB} {
+		handlerReg, err := informer.AddEventHandler(&eventToChannelHandler{
+			name:       pod.Name,
+			eventsChan: n.eventChan,
+		})
+		if err != nil {
+			t.Fatalf("AddEventHandler returned unexpected error: %v", err)
+		}
+		key := "Pod/" + pod.Name
+		namespacedWatches[key] = &watchRegistration{
+			cache:      n,
+			namespace:  pod.Namespace,
+			key:        key,
+			informer:   informer,
+			handlerReg: handlerReg,
+		}
+	}
+	n.watchedResources["my-namespace"] = namespacedWatches
+
+	for range namespacedWatches {
+		select {
+		case <-n.EventStream():
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for an initial add event")
+		}
+	}
+
+	if err := n.RemoveAllForNamespace("my-namespace"); err != nil {
+		t.Fatalf("RemoveAllForNamespace returned unexpected error: %v", err)
+	}
+
+	if _, ok := n.watchedResources["my-namespace"]; ok {
+		t.Fatal("expected the namespace to be pruned from watchedResources")
+	}
+
+	updated := podA.DeepCopy()
+	updated.Labels = map[string]string{"updated": "true"}
+	if err := source.Modify(updated); err != nil {
+		t.Fatalf("Modify returned unexpected error: %v", err)
+	}
+
+	select {
+	case evt := <-n.EventStream():
+		t.Fatalf("expected no event after RemoveAllForNamespace, got %#v", evt)
+	case <-time.After(time.Second):
+	}
+}
@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"context"
+
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/openshift/cluster-cloud-controller-manager-operator/tmp/pkg/multicluster"
+	"github.com/openshift/cluster-cloud-controller-manager-operator/tmp/pkg/platform"
+)
+
+// fleetWatcher is a manager.Runnable that watches provider for member
+// clusters being added and emits an event for every owner object it finds
+// there, so InfrastructureController reconciles clusters discovered after
+// startup too, rather than only the ones Owner.Init saw on the operator's
+// own cluster.
+type fleetWatcher struct {
+	provider multicluster.Provider
+	owner    platform.PlatformOwner
+	events   chan<- event.GenericEvent
+}
+
+func (w *fleetWatcher) Start(ctx context.Context) error {
+	names, err := w.provider.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		w.enqueueAll(ctx, name)
+	}
+
+	events, err := w.provider.Watch(ctx)
+	if err != nil {
+		return err
+	}
+
+	for ev := range events {
+		if ev.Type != multicluster.ClusterAdded {
+			// Nothing to reconcile on removal: the provider no longer
+			// resolves the cluster, so a future reconcile against it will
+			// simply error out rather than silently going stale.
+			continue
+		}
+		w.enqueueAll(ctx, ev.Name)
+	}
+
+	return nil
+}
+
+// enqueueAll lists clusterName's owner objects and emits an event for each,
+// with its namespace set to clusterName so InfrastructureController's
+// reconcile.Request carries the right member cluster, mirroring the
+// Namespace-as-cluster-name convention Owner.Mapper uses for the operator's
+// own cluster.
+func (w *fleetWatcher) enqueueAll(ctx context.Context, clusterName string) {
+	memberCluster, err := w.provider.Get(ctx, clusterName)
+	if err != nil {
+		klog.Errorf("fleet watcher: unable to resolve member cluster %q: %v", clusterName, err)
+		return
+	}
+
+	owners, err := w.owner.ListOwners(ctx, memberCluster.GetClient())
+	if err != nil {
+		klog.Errorf("fleet watcher: unable to list owners in member cluster %q: %v", clusterName, err)
+		return
+	}
+
+	for _, owner := range owners {
+		owner.SetNamespace(clusterName)
+		w.events <- event.GenericEvent{Object: owner}
+	}
+}
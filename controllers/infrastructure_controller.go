@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/openshift/cluster-cloud-controller-manager-operator/tmp/pkg/multicluster"
+	"github.com/openshift/cluster-cloud-controller-manager-operator/tmp/pkg/platform"
+)
+
+// InfrastructureController reconciles a PlatformOwner's owned resources
+// across every member cluster known to Provider. A single instance of this
+// controller services the whole fleet: req.Namespace carries the member
+// cluster name, and req.Name the Infrastructure object's name within it.
+type InfrastructureController struct {
+	Owner    platform.PlatformOwner
+	Provider multicluster.Provider
+	Scheme   *runtime.Scheme
+}
+
+func (r *InfrastructureController) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	memberCluster, _, resources, err := r.Owner.GetOwner(ctx, r.Provider, req.NamespacedName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	memberClient := memberCluster.GetClient()
+
+	for _, resource := range resources {
+		if err := memberClient.Patch(ctx, resource, client.Apply, client.ForceOwnership, client.FieldOwner("cluster-cloud-controller-manager-operator")); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// SetupWithManager wires r into mgr. If r.Provider is unset, it defaults to
+// the trivial self Provider backed by mgr's own cluster, i.e. single-cluster
+// mode; callers that want fleet-wide reconciliation (e.g. via
+// multicluster.NewSecretProvider) should set r.Provider before calling this.
+func (r *InfrastructureController) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Provider == nil {
+		r.Provider = multicluster.NewSelfProvider(mgr)
+	}
+
+	if !r.Owner.Init(r.Scheme) {
+		return nil
+	}
+
+	// Watches(r.Owner.Object(), ...) below only reacts to changes on the
+	// operator's own cluster. fleetWatcher is what makes Provider actually
+	// matter: it lists and watches Provider for member clusters and enqueues
+	// a request for every owner object it finds in each of them.
+	fleetEvents := make(chan event.GenericEvent)
+	if err := mgr.Add(&fleetWatcher{provider: r.Provider, owner: r.Owner, events: fleetEvents}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("infrastructure").
+		Watches(r.Owner.Object(), handler.EnqueueRequestsFromMapFunc(r.Owner.Mapper())).
+		WatchesRawSource(source.Channel(fleetEvents, &handler.EnqueueRequestForObject{})).
+		Complete(r)
+}
@@ -0,0 +1,33 @@
+package cloud
+
+import (
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	RegisterProvider(&alibabaCloudProvider{})
+}
+
+type alibabaCloudProvider struct{}
+
+func (p *alibabaCloudProvider) PlatformType() configv1.PlatformType {
+	return configv1.AlibabaCloudPlatformType
+}
+
+func (p *alibabaCloudProvider) Resources(cfg ProviderConfig) []client.Object {
+	return p.Defaults()
+}
+
+func (p *alibabaCloudProvider) Defaults() []client.Object {
+	return []client.Object{newCloudControllerManagerDeployment("alibabacloud")}
+}
+
+func (p *alibabaCloudProvider) Validate(infra *configv1.Infrastructure) error {
+	if infra.Status.PlatformStatus == nil || infra.Status.PlatformStatus.AlibabaCloud == nil {
+		return fmt.Errorf("infrastructure status is missing AlibabaCloud platform status")
+	}
+	return nil
+}
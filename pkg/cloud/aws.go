@@ -0,0 +1,28 @@
+package cloud
+
+import (
+	configv1 "github.com/openshift/api/config/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	RegisterProvider(&awsProvider{})
+}
+
+type awsProvider struct{}
+
+func (p *awsProvider) PlatformType() configv1.PlatformType {
+	return configv1.AWSPlatformType
+}
+
+func (p *awsProvider) Resources(cfg ProviderConfig) []client.Object {
+	return GetAWSResources()
+}
+
+func (p *awsProvider) Defaults() []client.Object {
+	return GetAWSResources()
+}
+
+func (p *awsProvider) Validate(infra *configv1.Infrastructure) error {
+	return nil
+}
@@ -0,0 +1,55 @@
+package cloud
+
+import (
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	RegisterProvider(&azureProvider{})
+}
+
+type azureProvider struct{}
+
+func (p *azureProvider) PlatformType() configv1.PlatformType {
+	return configv1.AzurePlatformType
+}
+
+func (p *azureProvider) Resources(cfg ProviderConfig) []client.Object {
+	var status *configv1.AzurePlatformStatus
+	if cfg.Infrastructure != nil && cfg.Infrastructure.Status.PlatformStatus != nil {
+		status = cfg.Infrastructure.Status.PlatformStatus.Azure
+	}
+	return p.resources(status)
+}
+
+func (p *azureProvider) Defaults() []client.Object {
+	return p.resources(nil)
+}
+
+func (p *azureProvider) Validate(infra *configv1.Infrastructure) error {
+	if infra.Status.PlatformStatus == nil || infra.Status.PlatformStatus.Azure == nil {
+		return fmt.Errorf("infrastructure status is missing Azure platform status")
+	}
+	return nil
+}
+
+// resources renders the Azure cloud-controller-manager Deployment, setting
+// the cloud environment from status when available so the manifest points
+// at the right Azure cloud (public, government, etc).
+func (p *azureProvider) resources(status *configv1.AzurePlatformStatus) []client.Object {
+	cloudName := string(configv1.AzurePublicCloud)
+	if status != nil && status.CloudName != "" {
+		cloudName = string(status.CloudName)
+	}
+
+	deployment := newCloudControllerManagerDeployment("azure", corev1.EnvVar{
+		Name:  "AZURE_ENVIRONMENT",
+		Value: cloudName,
+	})
+
+	return []client.Object{deployment}
+}
@@ -0,0 +1,54 @@
+package cloud
+
+import (
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	RegisterProvider(&gcpProvider{})
+}
+
+type gcpProvider struct{}
+
+func (p *gcpProvider) PlatformType() configv1.PlatformType {
+	return configv1.GCPPlatformType
+}
+
+func (p *gcpProvider) Resources(cfg ProviderConfig) []client.Object {
+	var status *configv1.GCPPlatformStatus
+	if cfg.Infrastructure != nil && cfg.Infrastructure.Status.PlatformStatus != nil {
+		status = cfg.Infrastructure.Status.PlatformStatus.GCP
+	}
+	return p.resources(status)
+}
+
+func (p *gcpProvider) Defaults() []client.Object {
+	return p.resources(nil)
+}
+
+func (p *gcpProvider) Validate(infra *configv1.Infrastructure) error {
+	if infra.Status.PlatformStatus == nil || infra.Status.PlatformStatus.GCP == nil {
+		return fmt.Errorf("infrastructure status is missing GCP platform status")
+	}
+	return nil
+}
+
+// resources renders the GCP cloud-controller-manager Deployment, threading
+// the project ID from status through when it is available.
+func (p *gcpProvider) resources(status *configv1.GCPPlatformStatus) []client.Object {
+	var projectID string
+	if status != nil {
+		projectID = status.ProjectID
+	}
+
+	deployment := newCloudControllerManagerDeployment("gcp", corev1.EnvVar{
+		Name:  "GCP_PROJECT_ID",
+		Value: projectID,
+	})
+
+	return []client.Object{deployment}
+}
@@ -8,19 +8,33 @@ import (
 
 type OwnedResources []client.Object
 
+// resourceKey identifies a distinct owned resource. GroupVersionKind alone
+// isn't enough: every platform's Deployment shares the same
+// {apps/v1, Deployment} GVK, so keying on that alone would keep only one
+// platform's Deployment at random (map iteration order is not stable).
+type resourceKey struct {
+	gvk       schema.GroupVersionKind
+	namespace string
+	name      string
+}
+
+// OwnedResourcesGroup returns the union, deduplicated by
+// (GroupVersionKind, Namespace, Name), of every registered Provider's
+// default resources.
 func OwnedResourcesGroup() OwnedResources {
 	resourceDistinct := OwnedResources{}
-	resourceUnion := []OwnedResources{
-		GetAWSResources(),
-	}
 
-	set := map[schema.GroupVersionKind]struct{}{}
-	for _, platformGroup := range resourceUnion {
-		for _, resource := range platformGroup {
-			objType := resource.GetObjectKind().GroupVersionKind()
-			klog.Info(objType)
-			if _, ok := set[objType]; !ok {
-				set[objType] = struct{}{}
+	set := map[resourceKey]struct{}{}
+	for _, provider := range Providers() {
+		for _, resource := range provider.Defaults() {
+			key := resourceKey{
+				gvk:       resource.GetObjectKind().GroupVersionKind(),
+				namespace: resource.GetNamespace(),
+				name:      resource.GetName(),
+			}
+			klog.Info(key.gvk)
+			if _, ok := set[key]; !ok {
+				set[key] = struct{}{}
 				resourceDistinct = append(resourceDistinct, resource)
 			}
 		}
@@ -0,0 +1,33 @@
+package cloud
+
+import (
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	RegisterProvider(&ibmCloudProvider{})
+}
+
+type ibmCloudProvider struct{}
+
+func (p *ibmCloudProvider) PlatformType() configv1.PlatformType {
+	return configv1.IBMCloudPlatformType
+}
+
+func (p *ibmCloudProvider) Resources(cfg ProviderConfig) []client.Object {
+	return p.Defaults()
+}
+
+func (p *ibmCloudProvider) Defaults() []client.Object {
+	return []client.Object{newCloudControllerManagerDeployment("ibmcloud")}
+}
+
+func (p *ibmCloudProvider) Validate(infra *configv1.Infrastructure) error {
+	if infra.Status.PlatformStatus == nil || infra.Status.PlatformStatus.IBMCloud == nil {
+		return fmt.Errorf("infrastructure status is missing IBMCloud platform status")
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package cloud
+
+import (
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	RegisterProvider(&nutanixProvider{})
+}
+
+type nutanixProvider struct{}
+
+func (p *nutanixProvider) PlatformType() configv1.PlatformType {
+	return configv1.NutanixPlatformType
+}
+
+func (p *nutanixProvider) Resources(cfg ProviderConfig) []client.Object {
+	return p.Defaults()
+}
+
+func (p *nutanixProvider) Defaults() []client.Object {
+	return []client.Object{newCloudControllerManagerDeployment("nutanix")}
+}
+
+func (p *nutanixProvider) Validate(infra *configv1.Infrastructure) error {
+	if infra.Status.PlatformStatus == nil || infra.Status.PlatformStatus.Nutanix == nil {
+		return fmt.Errorf("infrastructure status is missing Nutanix platform status")
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package cloud
+
+import (
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	RegisterProvider(&openStackProvider{})
+}
+
+type openStackProvider struct{}
+
+func (p *openStackProvider) PlatformType() configv1.PlatformType {
+	return configv1.OpenStackPlatformType
+}
+
+func (p *openStackProvider) Resources(cfg ProviderConfig) []client.Object {
+	return p.Defaults()
+}
+
+func (p *openStackProvider) Defaults() []client.Object {
+	return []client.Object{newCloudControllerManagerDeployment("openstack")}
+}
+
+func (p *openStackProvider) Validate(infra *configv1.Infrastructure) error {
+	if infra.Status.PlatformStatus == nil || infra.Status.PlatformStatus.OpenStack == nil {
+		return fmt.Errorf("infrastructure status is missing OpenStack platform status")
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package cloud
+
+import (
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	RegisterProvider(&powerVSProvider{})
+}
+
+type powerVSProvider struct{}
+
+func (p *powerVSProvider) PlatformType() configv1.PlatformType {
+	return configv1.PowerVSPlatformType
+}
+
+func (p *powerVSProvider) Resources(cfg ProviderConfig) []client.Object {
+	return p.Defaults()
+}
+
+func (p *powerVSProvider) Defaults() []client.Object {
+	return []client.Object{newCloudControllerManagerDeployment("powervs")}
+}
+
+func (p *powerVSProvider) Validate(infra *configv1.Infrastructure) error {
+	if infra.Status.PlatformStatus == nil || infra.Status.PlatformStatus.PowerVS == nil {
+		return fmt.Errorf("infrastructure status is missing PowerVS platform status")
+	}
+	return nil
+}
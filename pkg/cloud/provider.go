@@ -0,0 +1,68 @@
+package cloud
+
+import (
+	configv1 "github.com/openshift/api/config/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ProviderConfig carries the information a Provider needs to render its
+// owned resources for a particular cluster.
+type ProviderConfig struct {
+	// Infrastructure is the cluster's Infrastructure object. Its Status (and,
+	// where the config API only exposes it there, its Spec.PlatformSpec)
+	// carries the platform-specific fields - e.g. Azure cloud environment,
+	// GCP project ID, vSphere failure domains - a Provider may need in order
+	// to render provider-correct resources.
+	Infrastructure *configv1.Infrastructure
+}
+
+// Provider knows how to render the cloud-controller-manager resources for a
+// single cloud platform. Each supported platform registers an implementation
+// via RegisterProvider so that callers can go from a configv1.PlatformType to
+// its resources without a hardcoded switch.
+type Provider interface {
+	// PlatformType returns the configv1.PlatformType this Provider handles.
+	PlatformType() configv1.PlatformType
+
+	// Resources returns the set of objects CCCMO should own for cfg's
+	// Infrastructure.
+	Resources(cfg ProviderConfig) []client.Object
+
+	// Defaults returns the baseline set of objects for this platform, with
+	// no Infrastructure-specific values applied. Used by OwnedResourcesGroup
+	// to build the full set of resources CCCMO may ever own.
+	Defaults() []client.Object
+
+	// Validate returns an error if infra does not carry the fields this
+	// Provider requires in order to render its resources.
+	Validate(infra *configv1.Infrastructure) error
+}
+
+var providers = map[configv1.PlatformType]Provider{}
+
+// RegisterProvider registers p against its PlatformType. It is expected to
+// be called from the init function of each Provider's file, and panics on a
+// duplicate registration since that always indicates a programming error.
+func RegisterProvider(p Provider) {
+	platformType := p.PlatformType()
+	if _, ok := providers[platformType]; ok {
+		panic("cloud: provider already registered for platform " + string(platformType))
+	}
+	providers[platformType] = p
+}
+
+// ProviderFor returns the registered Provider for platformType, or false if
+// no Provider is registered for it.
+func ProviderFor(platformType configv1.PlatformType) (Provider, bool) {
+	p, ok := providers[platformType]
+	return p, ok
+}
+
+// Providers returns every registered Provider.
+func Providers() []Provider {
+	all := make([]Provider, 0, len(providers))
+	for _, p := range providers {
+		all = append(all, p)
+	}
+	return all
+}
@@ -0,0 +1,40 @@
+package cloud
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// targetNamespace is the namespace every cloud-controller-manager Deployment
+// is rendered into.
+const targetNamespace = "openshift-cloud-controller-manager"
+
+// newCloudControllerManagerDeployment builds the Deployment skeleton shared
+// by every provider, with any platform-specific environment variables set on
+// the cloud-controller-manager container so the manifest renderer does not
+// need provider switches of its own.
+func newCloudControllerManagerDeployment(platform string, env ...corev1.EnvVar) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      platform + "-cloud-controller-manager",
+			Namespace: targetNamespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "cloud-controller-manager",
+							Env:  env,
+						},
+					},
+				},
+			},
+		},
+	}
+}
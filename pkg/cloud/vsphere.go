@@ -0,0 +1,58 @@
+package cloud
+
+import (
+	"fmt"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	RegisterProvider(&vsphereProvider{})
+}
+
+type vsphereProvider struct{}
+
+func (p *vsphereProvider) PlatformType() configv1.PlatformType {
+	return configv1.VSpherePlatformType
+}
+
+func (p *vsphereProvider) Resources(cfg ProviderConfig) []client.Object {
+	var spec *configv1.VSpherePlatformSpec
+	if cfg.Infrastructure != nil {
+		spec = cfg.Infrastructure.Spec.PlatformSpec.VSphere
+	}
+	return p.resources(spec)
+}
+
+func (p *vsphereProvider) Defaults() []client.Object {
+	return p.resources(nil)
+}
+
+func (p *vsphereProvider) Validate(infra *configv1.Infrastructure) error {
+	if infra.Status.PlatformStatus == nil || infra.Status.PlatformStatus.VSphere == nil {
+		return fmt.Errorf("infrastructure status is missing VSphere platform status")
+	}
+	return nil
+}
+
+// resources renders the vSphere cloud-controller-manager Deployment, passing
+// through the configured failure domain names so the controller manager
+// knows which vCenters/datacenters it is responsible for.
+func (p *vsphereProvider) resources(spec *configv1.VSpherePlatformSpec) []client.Object {
+	var failureDomains []string
+	if spec != nil {
+		for _, fd := range spec.FailureDomains {
+			failureDomains = append(failureDomains, fd.Name)
+		}
+	}
+
+	deployment := newCloudControllerManagerDeployment("vsphere", corev1.EnvVar{
+		Name:  "VSPHERE_FAILURE_DOMAINS",
+		Value: strings.Join(failureDomains, ","),
+	})
+
+	return []client.Object{deployment}
+}
@@ -0,0 +1,49 @@
+// Package multicluster lets a single CCCMO instance reconcile Infrastructure
+// objects and roll out cloud-controller-manager Deployments across a fleet
+// of managed clusters (e.g. hosted control planes), rather than only the
+// cluster it is itself running on.
+//
+// A Provider is handed to the top-level controller, which enqueues
+// reconcile.Requests whose Namespace carries the cluster name and resolves
+// each member cluster's client through Provider.Get, so a single controller
+// loop can service every member cluster the Provider knows about. A Provider
+// is not wired into manager.Options.NewCache/NewClient: the manager's own
+// cache and client serve only the cluster the operator runs on.
+package multicluster
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+)
+
+// EventType describes whether a member cluster was added to or removed from
+// the fleet a Provider is watching.
+type EventType string
+
+const (
+	ClusterAdded   EventType = "Added"
+	ClusterRemoved EventType = "Removed"
+)
+
+// Event is emitted on a Provider's Watch channel as member clusters come and
+// go from the fleet.
+type Event struct {
+	Type EventType
+	Name string
+}
+
+// Provider resolves and tracks the member clusters a single CCCMO instance
+// is responsible for.
+type Provider interface {
+	// Get returns the cluster.Cluster handle for the named member cluster.
+	Get(ctx context.Context, name string) (cluster.Cluster, error)
+
+	// List returns the names of every member cluster currently known to the
+	// Provider.
+	List(ctx context.Context) ([]string, error)
+
+	// Watch returns a channel of Events as member clusters are added to or
+	// removed from the fleet. The channel is closed when ctx is done.
+	Watch(ctx context.Context) (<-chan Event, error)
+}
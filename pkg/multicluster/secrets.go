@@ -0,0 +1,195 @@
+package multicluster
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+)
+
+// KubeconfigSecretType is the Secret type a member cluster's kubeconfig must
+// be stored under for the secret Provider to pick it up.
+const KubeconfigSecretType corev1.SecretType = "cluster.openshift.io/kubeconfig"
+
+// kubeconfigSecretKey is the Secret data key the kubeconfig payload is read
+// from.
+const kubeconfigSecretKey = "kubeconfig"
+
+// secretProviderPollInterval is how often the secret Provider's Watch polls
+// for fleet Secrets being added or removed.
+const secretProviderPollInterval = 30 * time.Second
+
+// SecretProviderOptions configures NewSecretProvider.
+type SecretProviderOptions struct {
+	// Client is used to list and get the management cluster's Secrets.
+	Client client.Client
+
+	// Namespace is the management namespace fleet Secrets are read from.
+	Namespace string
+
+	// NewCluster constructs a cluster.Cluster for the named member cluster
+	// from its kubeconfig bytes. Defaults to cluster.New against a
+	// rest.Config built straight from the kubeconfig.
+	NewCluster func(kubeconfig []byte, name string) (cluster.Cluster, error)
+}
+
+// NewSecretProvider returns a Provider that discovers member clusters from
+// Secrets of type KubeconfigSecretType in opts.Namespace, keyed by Secret
+// name, similar to the fleet-namespace pattern used for hosted control
+// planes.
+func NewSecretProvider(opts SecretProviderOptions) (Provider, error) {
+	if opts.Client == nil {
+		return nil, fmt.Errorf("Client is required")
+	}
+	if opts.Namespace == "" {
+		return nil, fmt.Errorf("Namespace is required")
+	}
+
+	return &secretProvider{
+		client:     opts.Client,
+		namespace:  opts.Namespace,
+		newCluster: opts.NewCluster,
+		clusters:   make(map[string]cluster.Cluster),
+	}, nil
+}
+
+type secretProvider struct {
+	client     client.Client
+	namespace  string
+	newCluster func(kubeconfig []byte, name string) (cluster.Cluster, error)
+
+	mu       sync.RWMutex
+	clusters map[string]cluster.Cluster
+}
+
+func (p *secretProvider) Get(ctx context.Context, name string) (cluster.Cluster, error) {
+	p.mu.RLock()
+	c, ok := p.clusters[name]
+	p.mu.RUnlock()
+	if ok {
+		return c, nil
+	}
+
+	// Hold the write lock across the miss path, re-checking the cache once
+	// it's held, so that two concurrent Gets for the same uncached name
+	// can't each build their own cluster.Cluster and have one silently
+	// overwrite (and leak) the other's.
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if c, ok := p.clusters[name]; ok {
+		return c, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := p.client.Get(ctx, client.ObjectKey{Namespace: p.namespace, Name: name}, secret); err != nil {
+		return nil, fmt.Errorf("could not get kubeconfig secret for cluster %q: %w", name, err)
+	}
+
+	c, err := p.clusterFromSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	// c's client is cache-backed, so its cache must be running, and synced,
+	// before any Get/List against it can succeed. Run it for the life of
+	// the provider rather than ctx, which only scopes this one call.
+	clusterCtx, cancel := context.WithCancel(context.Background())
+	go c.Start(clusterCtx)
+	if !c.GetCache().WaitForCacheSync(ctx) {
+		cancel()
+		return nil, fmt.Errorf("cache for member cluster %q never synced", name)
+	}
+
+	p.clusters[name] = c
+
+	return c, nil
+}
+
+func (p *secretProvider) List(ctx context.Context) ([]string, error) {
+	secrets := &corev1.SecretList{}
+	if err := p.client.List(ctx, secrets, client.InNamespace(p.namespace)); err != nil {
+		return nil, fmt.Errorf("could not list kubeconfig secrets: %w", err)
+	}
+
+	names := make([]string, 0, len(secrets.Items))
+	for _, secret := range secrets.Items {
+		if secret.Type != KubeconfigSecretType {
+			continue
+		}
+		names = append(names, secret.Name)
+	}
+
+	return names, nil
+}
+
+// Watch polls List every secretProviderPollInterval and diffs the result
+// against what was last seen, emitting an Event for every cluster that
+// appeared or disappeared.
+func (p *secretProvider) Watch(ctx context.Context) (<-chan Event, error) {
+	events := make(chan Event)
+
+	go func() {
+		defer close(events)
+
+		known := map[string]struct{}{}
+		ticker := time.NewTicker(secretProviderPollInterval)
+		defer ticker.Stop()
+
+		for {
+			if names, err := p.List(ctx); err != nil {
+				klog.Errorf("could not list fleet secrets: %v", err)
+			} else {
+				seen := make(map[string]struct{}, len(names))
+				for _, name := range names {
+					seen[name] = struct{}{}
+					if _, ok := known[name]; !ok {
+						events <- Event{Type: ClusterAdded, Name: name}
+					}
+				}
+				for name := range known {
+					if _, ok := seen[name]; !ok {
+						events <- Event{Type: ClusterRemoved, Name: name}
+					}
+				}
+				known = seen
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func (p *secretProvider) clusterFromSecret(secret *corev1.Secret) (cluster.Cluster, error) {
+	if secret.Type != KubeconfigSecretType {
+		return nil, fmt.Errorf("secret %s/%s is not of type %s", secret.Namespace, secret.Name, KubeconfigSecretType)
+	}
+
+	kubeconfig, ok := secret.Data[kubeconfigSecretKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s has no %q key", secret.Namespace, secret.Name, kubeconfigSecretKey)
+	}
+
+	if p.newCluster != nil {
+		return p.newCluster(kubeconfig, secret.Name)
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not build rest.Config from kubeconfig for cluster %q: %w", secret.Name, err)
+	}
+
+	return cluster.New(restConfig)
+}
@@ -0,0 +1,47 @@
+package multicluster
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+)
+
+// SelfClusterName is the cluster name the self Provider always reports,
+// since it only ever manages the cluster the operator itself is running on.
+const SelfClusterName = "self"
+
+// NewSelfProvider returns a Provider that treats the cluster the operator is
+// running on as the only member cluster, using c directly rather than
+// constructing a new cluster.Cluster. This is the trivial single-cluster
+// mode CCCMO runs in when no fleet is configured.
+func NewSelfProvider(c cluster.Cluster) Provider {
+	return &selfProvider{cluster: c}
+}
+
+type selfProvider struct {
+	cluster cluster.Cluster
+}
+
+func (p *selfProvider) Get(ctx context.Context, name string) (cluster.Cluster, error) {
+	if name != SelfClusterName {
+		return nil, fmt.Errorf("unknown cluster %q: self provider only manages %q", name, SelfClusterName)
+	}
+	return p.cluster, nil
+}
+
+func (p *selfProvider) List(ctx context.Context) ([]string, error) {
+	return []string{SelfClusterName}, nil
+}
+
+func (p *selfProvider) Watch(ctx context.Context) (<-chan Event, error) {
+	events := make(chan Event, 1)
+	events <- Event{Type: ClusterAdded, Name: SelfClusterName}
+
+	go func() {
+		<-ctx.Done()
+		close(events)
+	}()
+
+	return events, nil
+}
@@ -2,12 +2,15 @@ package platform
 
 import (
 	"context"
+	"fmt"
 
 	configv1 "github.com/openshift/api/config/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/openshift/cluster-cloud-controller-manager-operator/tmp/pkg/cloud"
+	"github.com/openshift/cluster-cloud-controller-manager-operator/tmp/pkg/multicluster"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -19,7 +22,7 @@ import (
 var _ PlatformOwner = &InfrastrucutreOwner{}
 
 type InfrastrucutreOwner struct {
-	objects []configv1.Infrastructure
+	objects []client.Object
 }
 
 func (o *InfrastrucutreOwner) Object() client.Object {
@@ -34,45 +37,82 @@ func (o *InfrastrucutreOwner) Init(scheme *runtime.Scheme) bool {
 		klog.Fatalf("Unable to open client: %v", err)
 	}
 
-	infraList := &configv1.InfrastructureList{}
-	if err := c.List(context.TODO(), infraList); err != nil {
-		klog.Errorf("Unable to retrive list of platform %T objects: %v", infraList, err)
+	owners, err := o.ListOwners(context.TODO(), c)
+	if err != nil {
+		klog.Errorf("Unable to retrive list of platform objects: %v", err)
 		return false
-	} else if len(infraList.Items) == 0 {
+	} else if len(owners) == 0 {
 		return false
 	}
 
-	o.objects = infraList.Items
+	o.objects = owners
 	return true
 }
 
 func (o *InfrastrucutreOwner) Mapper() handler.MapFunc {
 	mapObjects := []reconcile.Request{}
 	for _, infra := range o.objects {
+		// Namespace carries the member cluster name rather than a real
+		// Kubernetes namespace, since Infrastructure is cluster-scoped. Init
+		// only ever lists from the operator's own cluster today, so that is
+		// always multicluster.SelfClusterName until it is made fleet-aware.
 		mapObjects = append(mapObjects, reconcile.Request{
-			NamespacedName: client.ObjectKeyFromObject(&infra),
+			NamespacedName: client.ObjectKey{
+				Namespace: multicluster.SelfClusterName,
+				Name:      infra.GetName(),
+			},
 		})
 	}
 	return func(client.Object) []reconcile.Request { return mapObjects }
 }
 
-func (o *InfrastrucutreOwner) GetOwner(ctx context.Context, с client.Client, key client.ObjectKey) (metav1.Object, []client.Object, error) {
-	infra := &configv1.Infrastructure{}
-	err := с.Get(ctx, key, infra)
+func (o *InfrastrucutreOwner) GetOwner(ctx context.Context, provider multicluster.Provider, key client.ObjectKey) (cluster.Cluster, metav1.Object, []client.Object, error) {
+	memberCluster, err := provider.Get(ctx, key.Namespace)
 	if err != nil {
+		klog.Errorf("Unable to resolve member cluster %q: %v", key.Namespace, err)
+		return nil, nil, nil, err
+	}
+
+	infra := &configv1.Infrastructure{}
+	if err := memberCluster.GetClient().Get(ctx, client.ObjectKey{Name: key.Name}, infra); err != nil {
 		klog.Errorf("Unable to retrive platform %T object: %v", infra, err)
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return infra, getResources(infra.Status.Platform), nil
+	return memberCluster, infra, getResources(infra), nil
 }
 
-func getResources(platformType configv1.PlatformType) []client.Object {
-	switch platformType {
-	case configv1.AWSPlatformType:
-		return cloud.GetAWSResources()
-	default:
-		klog.Warning("No recognized cloud provider platform found in infrastructure")
+// ListOwners lists every Infrastructure object present in the cluster c is
+// a client for.
+func (o *InfrastrucutreOwner) ListOwners(ctx context.Context, c client.Client) ([]client.Object, error) {
+	infraList := &configv1.InfrastructureList{}
+	if err := c.List(ctx, infraList); err != nil {
+		return nil, fmt.Errorf("unable to list %T objects: %w", infraList, err)
 	}
-	return nil
+
+	owners := make([]client.Object, 0, len(infraList.Items))
+	for i := range infraList.Items {
+		owners = append(owners, &infraList.Items[i])
+	}
+	return owners, nil
+}
+
+// getResources looks up the registered cloud.Provider for infra's platform
+// and asks it to render its resources, threading the platform-specific
+// fields carried on infra (e.g. Azure cloud environment and GCP project ID
+// from its status, vSphere failure domains from its spec) through to the
+// provider.
+func getResources(infra *configv1.Infrastructure) []client.Object {
+	provider, ok := cloud.ProviderFor(infra.Status.Platform)
+	if !ok {
+		klog.Warningf("No recognized cloud provider platform found in infrastructure: %s", infra.Status.Platform)
+		return nil
+	}
+
+	if err := provider.Validate(infra); err != nil {
+		klog.Errorf("Infrastructure is not valid for platform %s: %v", infra.Status.Platform, err)
+		return nil
+	}
+
+	return provider.Resources(cloud.ProviderConfig{Infrastructure: infra})
 }
@@ -6,12 +6,24 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	"github.com/openshift/cluster-cloud-controller-manager-operator/tmp/pkg/multicluster"
 )
 
 type PlatformOwner interface {
 	Init(*runtime.Scheme) bool
-	GetOwner(ctx context.Context, с client.Client, key client.ObjectKey) (metav1.Object, []client.Object, error)
+	// GetOwner resolves key's owner object from the member cluster named by
+	// key.Namespace, using provider to obtain that cluster's client. It
+	// returns the resolved cluster alongside the owner and its resources so
+	// a caller that also needs that cluster's client (e.g. to apply the
+	// returned resources) doesn't have to resolve it a second time.
+	GetOwner(ctx context.Context, provider multicluster.Provider, key client.ObjectKey) (cluster.Cluster, metav1.Object, []client.Object, error)
+	// ListOwners lists every owner object present in the cluster c is a
+	// client for, e.g. so a fleet watcher can enqueue a reconcile request
+	// per object when a new member cluster is discovered.
+	ListOwners(ctx context.Context, c client.Client) ([]client.Object, error)
 	Object() client.Object
 	Mapper() handler.MapFunc
 }